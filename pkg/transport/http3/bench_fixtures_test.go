@@ -0,0 +1,167 @@
+package http3
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	quichttp3 "github.com/quic-go/quic-go/http3"
+)
+
+// httpDoer is the subset of *http.Client the benchmarks exercise.
+type httpDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+func newGetRequest(ctx context.Context, url string) (*http.Request, error) {
+	return http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+}
+
+// lossyServer is the common shape returned by both fixtures.
+type lossyServer struct {
+	URL string
+
+	closeFn func()
+}
+
+func (s *lossyServer) Close() { s.closeFn() }
+
+// newLossyHTTP2Fixture serves bulkPolicyPayload over HTTP/2, with the
+// client's dial wrapped so a lossPercent fraction of writes pay a
+// retransmit-sized delay, approximating loss on a real TCP/TLS link.
+func newLossyHTTP2Fixture(b testing.TB, lossPercent int) (*lossyServer, httpDoer) {
+	b.Helper()
+
+	cert := selfSignedCert(b)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	payload := bulkPolicyPayload()
+	srv := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(payload)
+		}),
+		TLSConfig: &tls.Config{Certificates: []tls.Certificate{cert}},
+	}
+	go func() { _ = srv.ServeTLS(ln, "", "") }()
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			ForceAttemptHTTP2: true,
+			TLSClientConfig:   &tls.Config{InsecureSkipVerify: true},
+			DialTLSContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				raw, err := (&net.Dialer{}).DialContext(ctx, network, addr)
+				if err != nil {
+					return nil, err
+				}
+				// Wrap beneath TLS and offer h2 via ALPN so net/http sees a
+				// *tls.Conn that negotiated HTTP/2 rather than using HTTP/1.1.
+				conn := tls.Client(&lossyConn{Conn: raw, lossPercent: lossPercent}, &tls.Config{InsecureSkipVerify: true, NextProtos: []string{"h2"}})
+				if err := conn.HandshakeContext(ctx); err != nil {
+					_ = raw.Close()
+					return nil, err
+				}
+				return conn, nil
+			},
+		},
+	}
+
+	return &lossyServer{
+			URL:     "https://" + ln.Addr().String(),
+			closeFn: func() { _ = srv.Close() },
+		},
+		client
+}
+
+// newLossyHTTP3Fixture serves bulkPolicyPayload over HTTP/3, with the UDP
+// socket wrapped so a lossPercent fraction of outgoing datagrams are
+// silently dropped, matching how loss actually manifests for QUIC.
+func newLossyHTTP3Fixture(b testing.TB, lossPercent int) (*lossyServer, httpDoer) {
+	return newLossyHTTP3FixtureWithConfig(b, lossPercent, Config{})
+}
+
+// newLossyHTTP3FixtureWithConfig is newLossyHTTP3Fixture but lets the
+// caller override Config fields (e.g. EnableDatagrams) on top of the
+// fixture's Addr and DeviceCertPins.
+func newLossyHTTP3FixtureWithConfig(b testing.TB, lossPercent int, cfg Config) (*lossyServer, httpDoer) {
+	b.Helper()
+
+	cert := selfSignedCert(b)
+	udpConn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		b.Fatal(err)
+	}
+	lossyUDPConn := &lossyPacketConn{PacketConn: udpConn, lossPercent: lossPercent}
+
+	payload := bulkPolicyPayload()
+	h3Srv := &quichttp3.Server{
+		TLSConfig:       &tls.Config{Certificates: []tls.Certificate{cert}},
+		EnableDatagrams: cfg.EnableDatagrams,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(payload)
+		}),
+	}
+	go func() { _ = h3Srv.Serve(lossyUDPConn) }()
+
+	cfg.Addr = udpConn.LocalAddr().String()
+	cfg.DeviceCertPins = [][32]byte{sha256.Sum256(cert.Certificate[0])}
+	client, err := NewClient(cfg)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return &lossyServer{
+			URL:     "https://" + udpConn.LocalAddr().String(),
+			closeFn: func() { _ = h3Srv.Close() },
+		},
+		client
+}
+
+// lossyConn delays a lossPercent fraction of writes by a retransmit-sized
+// RTT, approximating the cost TCP pays to recover a dropped segment.
+type lossyConn struct {
+	net.Conn
+	lossPercent int
+}
+
+func (c *lossyConn) Write(p []byte) (int, error) {
+	if randomPercent() < c.lossPercent {
+		time.Sleep(50 * time.Millisecond)
+	}
+	return c.Conn.Write(p)
+}
+
+func selfSignedCert(b testing.TB) tls.Certificate {
+	b.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		b.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "fortigate-bench.local"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		b.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
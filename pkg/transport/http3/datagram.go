@@ -0,0 +1,54 @@
+package http3
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	"github.com/quic-go/quic-go"
+)
+
+// DatagramChannel is an unreliable QUIC datagram (RFC 9221) channel to a
+// FortiGate appliance, suitable for streaming syslog-style telemetry
+// where occasional drops are preferable to head-of-line blocking.
+type DatagramChannel struct {
+	conn quic.Connection
+}
+
+// OpenDatagramChannel dials addr over QUIC and returns a channel for
+// sending and receiving unreliable datagrams. cfg.EnableDatagrams must be
+// set, otherwise an error is returned without dialing.
+func OpenDatagramChannel(ctx context.Context, addr string, cfg Config) (*DatagramChannel, error) {
+	if !cfg.EnableDatagrams {
+		return nil, fmt.Errorf("http3: EnableDatagrams must be set to open a datagram channel")
+	}
+
+	tlsConf := &tls.Config{
+		InsecureSkipVerify:    true,
+		VerifyPeerCertificate: pinnedCertVerifier(cfg.DeviceCertPins),
+	}
+
+	conn, err := quic.DialAddr(ctx, addr, tlsConf, &quic.Config{
+		EnableDatagrams: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("http3: dial datagram channel: %w", err)
+	}
+
+	return &DatagramChannel{conn: conn}, nil
+}
+
+// Send writes a single unreliable datagram.
+func (d *DatagramChannel) Send(payload []byte) error {
+	return d.conn.SendDatagram(payload)
+}
+
+// Receive blocks until the next unreliable datagram arrives or ctx is done.
+func (d *DatagramChannel) Receive(ctx context.Context) ([]byte, error) {
+	return d.conn.ReceiveDatagram(ctx)
+}
+
+// Close tears down the underlying QUIC connection.
+func (d *DatagramChannel) Close() error {
+	return d.conn.CloseWithError(0, "")
+}
@@ -0,0 +1,28 @@
+package http3
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"fmt"
+)
+
+// pinnedCertVerifier returns a tls.Config.VerifyPeerCertificate func that
+// accepts a connection only if the leaf certificate's SHA-256 fingerprint
+// matches one of pins. It's used in place of normal CA verification
+// because FortiGate appliances are typically reached with a self-signed
+// or private-CA device cert.
+func pinnedCertVerifier(pins [][32]byte) func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return fmt.Errorf("http3: no certificate presented")
+		}
+
+		leaf := sha256.Sum256(rawCerts[0])
+		for _, pin := range pins {
+			if leaf == pin {
+				return nil
+			}
+		}
+		return fmt.Errorf("http3: device certificate does not match any pinned fingerprint")
+	}
+}
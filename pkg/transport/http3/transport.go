@@ -0,0 +1,142 @@
+// Package http3 provides an HTTP/3 + QUIC transport for talking to a
+// FortiGate appliance's REST API, falling back to HTTP/2 when the
+// appliance (or the network path) doesn't support QUIC. It exists for
+// FortiGate deployments behind lossy WAN links and NAT, where HTTP/2's
+// head-of-line blocking hurts large policy sync operations.
+package http3
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/quic-go/quic-go"
+	"github.com/quic-go/quic-go/http3"
+)
+
+// Config configures the FortiGate HTTP/3 transport.
+type Config struct {
+	// Addr is the FortiGate appliance's host:port.
+	Addr string
+
+	// DeviceCertPins are the SHA-256 fingerprints of the FortiGate
+	// device certs this client will accept, in place of normal CA
+	// verification.
+	DeviceCertPins [][32]byte
+
+	// Enable0RTT allows 0-RTT session resumption for idempotent GET
+	// requests. Non-idempotent requests never use 0-RTT, since an
+	// attacker able to replay early data could replay a mutating call.
+	Enable0RTT bool
+
+	// EnableDatagrams turns on unreliable HTTP Datagrams (RFC 9221) so
+	// callers can open a DatagramChannel for streaming syslog-style
+	// telemetry without head-of-line blocking.
+	EnableDatagrams bool
+}
+
+// NewClient builds an *http.Client that dials Addr over HTTP/3, falling
+// back to HTTP/2 against the same Addr whenever the HTTP/3 round trip
+// fails, e.g. when the appliance doesn't answer over QUIC.
+func NewClient(cfg Config) (*http.Client, error) {
+	if cfg.Addr == "" {
+		return nil, fmt.Errorf("http3: Addr is required")
+	}
+	if len(cfg.DeviceCertPins) == 0 {
+		return nil, fmt.Errorf("http3: at least one DeviceCertPins entry is required")
+	}
+
+	tlsConf := &tls.Config{
+		InsecureSkipVerify:    true, // verification is done by pinnedCertVerifier instead
+		VerifyPeerCertificate: pinnedCertVerifier(cfg.DeviceCertPins),
+	}
+
+	// dialAddr pins every QUIC dial to cfg.Addr regardless of the request
+	// URL's host, matching how the HTTP/2 fallback's tls.Dialer only ever
+	// reaches the FortiGate appliance. Whether the dial
+	// actually spends 0-RTT data is governed by quicCfg.Allow0RTT, which
+	// differs between the zeroRTT and safe RoundTrippers below.
+	dialAddr := func(ctx context.Context, _ string, tlsCfg *tls.Config, quicCfg *quic.Config) (quic.EarlyConnection, error) {
+		return quic.DialAddrEarly(ctx, cfg.Addr, tlsCfg, quicCfg)
+	}
+
+	rt := &fallbackRoundTripper{
+		zeroRTT: &http3.RoundTripper{
+			TLSClientConfig: tlsConf,
+			QuicConfig: &quic.Config{
+				Allow0RTT:       true,
+				EnableDatagrams: cfg.EnableDatagrams,
+			},
+			EnableDatagrams: cfg.EnableDatagrams,
+			Dial:            dialAddr,
+		},
+		safe: &http3.RoundTripper{
+			TLSClientConfig: tlsConf,
+			QuicConfig: &quic.Config{
+				Allow0RTT:       false,
+				EnableDatagrams: cfg.EnableDatagrams,
+			},
+			EnableDatagrams: cfg.EnableDatagrams,
+			Dial:            dialAddr,
+		},
+		http2: &http.Transport{
+			TLSClientConfig:   tlsConf,
+			ForceAttemptHTTP2: true,
+			DialTLSContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return (&tls.Dialer{Config: tlsConf}).DialContext(ctx, network, cfg.Addr)
+			},
+		},
+		enable0RTT: cfg.Enable0RTT,
+	}
+
+	return &http.Client{Transport: rt}, nil
+}
+
+// fallbackRoundTripper tries HTTP/3 first and falls back to HTTP/2 on any
+// HTTP/3 error, such as the appliance not speaking QUIC on Addr. It also
+// enforces that 0-RTT is
+// only ever used for idempotent GETs.
+type fallbackRoundTripper struct {
+	zeroRTT    *http3.RoundTripper // Allow0RTT: true, used only for idempotent GETs
+	safe       *http3.RoundTripper // Allow0RTT: false, used for everything else
+	http2      *http.Transport
+	enable0RTT bool
+}
+
+func (rt *fallbackRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	h3 := rt.safe
+	if rt.enable0RTT && isReplaySafe(req) {
+		h3 = rt.zeroRTT
+	}
+
+	resp, err := h3.RoundTrip(req)
+	if err == nil {
+		return resp, nil
+	}
+
+	// The HTTP/3 leg may have already read req.Body, so rebuild the
+	// request with a fresh body before retrying over HTTP/2 — otherwise
+	// a request with a body (e.g. apply's policy spec POST) would fall
+	// back with an empty or truncated body instead of erroring loudly.
+	retry := req
+	if req.GetBody != nil {
+		body, bodyErr := req.GetBody()
+		if bodyErr != nil {
+			return nil, fmt.Errorf("http3: rewind request body for HTTP/2 fallback: %w", bodyErr)
+		}
+		retry = req.Clone(req.Context())
+		retry.Body = body
+	} else if req.Body != nil && req.Body != http.NoBody {
+		return nil, fmt.Errorf("http3: request body cannot be rewound for HTTP/2 fallback: %w", err)
+	}
+
+	return rt.http2.RoundTrip(retry)
+}
+
+// isReplaySafe reports whether req may be safely replayed, and therefore
+// is eligible to ride along on a 0-RTT resumed session.
+func isReplaySafe(req *http.Request) bool {
+	return req.Method == http.MethodGet
+}
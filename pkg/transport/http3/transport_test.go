@@ -0,0 +1,30 @@
+package http3
+
+import (
+	"context"
+	"testing"
+)
+
+// TestNewClientWithDatagramsUsesHTTP3 guards against the mismatch between
+// http3.RoundTripper.EnableDatagrams and quic.Config.EnableDatagrams: if
+// only one of the two is set, quic-go refuses every request with "HTTP
+// Datagrams enabled, but QUIC Datagrams disabled", which fallbackRoundTripper
+// silently papers over by falling back to HTTP/2.
+func TestNewClientWithDatagramsUsesHTTP3(t *testing.T) {
+	srv, client := newLossyHTTP3FixtureWithConfig(t, 0, Config{EnableDatagrams: true})
+	defer srv.Close()
+
+	req, err := newGetRequest(context.Background(), srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("request failed, HTTP/3 leg likely errored and was papered over by HTTP/2 fallback: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.ProtoMajor != 3 {
+		t.Errorf("resp.ProtoMajor = %d, want 3 (request fell back to HTTP/2 instead of using HTTP/3)", resp.ProtoMajor)
+	}
+}
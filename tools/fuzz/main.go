@@ -0,0 +1,4 @@
+// Package main is a placeholder for future FortiGate API client fuzz targets.
+package main
+
+func main() {}
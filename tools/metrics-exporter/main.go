@@ -0,0 +1,4 @@
+// Package main is a placeholder for the future metrics-exporter tool.
+package main
+
+func main() {}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var applyCmd = &cobra.Command{
+	Use:   "apply <policy-spec>",
+	Short: "Push a FortiGate policy spec to the nextrade core",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		spec, err := os.ReadFile(args[0])
+		if err != nil {
+			return fmt.Errorf("read policy spec: %w", err)
+		}
+
+		policy, err := client().ApplyPolicy(context.Background(), spec)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("policy %s applied: %s\n", policy.ID, policy.Status)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(applyCmd)
+}
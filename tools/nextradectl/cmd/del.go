@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var delCmd = &cobra.Command{
+	Use:   "del <policy-id>",
+	Short: "Delete a single FortiGate policy",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		if err := client().DeletePolicy(context.Background(), args[0]); err != nil {
+			return err
+		}
+
+		fmt.Printf("policy %s deleted\n", args[0])
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(delCmd)
+}
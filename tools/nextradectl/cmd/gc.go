@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/fortinet/nextrade/tools/nextradectl/internal/nextradeapi"
+)
+
+var gcCmd = &cobra.Command{
+	Use:   "gc [keep-ids...]",
+	Short: "Delete every policy not in keep-ids (declarative reconciliation)",
+	RunE: func(c *cobra.Command, args []string) error {
+		keep := make(map[string]bool, len(args))
+		for _, id := range args {
+			keep[id] = true
+		}
+
+		ctx := context.Background()
+		cli := client()
+		policies, err := cli.ListPolicies(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, policy := range policiesToDelete(policies, keep) {
+			if err := cli.DeletePolicy(ctx, policy.ID); err != nil {
+				return fmt.Errorf("gc policy %s: %w", policy.ID, err)
+			}
+			fmt.Printf("policy %s garbage collected\n", policy.ID)
+		}
+		return nil
+	},
+}
+
+// policiesToDelete returns the policies in policies whose ID isn't in keep.
+func policiesToDelete(policies []nextradeapi.Policy, keep map[string]bool) []nextradeapi.Policy {
+	var toDelete []nextradeapi.Policy
+	for _, policy := range policies {
+		if !keep[policy.ID] {
+			toDelete = append(toDelete, policy)
+		}
+	}
+	return toDelete
+}
+
+func init() {
+	rootCmd.AddCommand(gcCmd)
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/fortinet/nextrade/tools/nextradectl/internal/nextradeapi"
+)
+
+func TestPoliciesToDelete(t *testing.T) {
+	policies := []nextradeapi.Policy{
+		{ID: "a"},
+		{ID: "b"},
+		{ID: "c"},
+	}
+
+	tests := []struct {
+		name string
+		keep map[string]bool
+		want []nextradeapi.Policy
+	}{
+		{
+			name: "keeps nothing deletes all",
+			keep: map[string]bool{},
+			want: []nextradeapi.Policy{{ID: "a"}, {ID: "b"}, {ID: "c"}},
+		},
+		{
+			name: "keeps some deletes the rest",
+			keep: map[string]bool{"b": true},
+			want: []nextradeapi.Policy{{ID: "a"}, {ID: "c"}},
+		},
+		{
+			name: "keeps everything deletes nothing",
+			keep: map[string]bool{"a": true, "b": true, "c": true},
+			want: nil,
+		},
+		{
+			name: "keep ids not present in policies are ignored",
+			keep: map[string]bool{"z": true},
+			want: []nextradeapi.Policy{{ID: "a"}, {ID: "b"}, {ID: "c"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := policiesToDelete(policies, tt.keep)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("policiesToDelete(%v, %v) = %v, want %v", policies, tt.keep, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Package cmd holds the nextradectl subcommands, one file per verb,
+// mirroring the cnitool add/del/status/gc layout.
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/spf13/cobra"
+
+	nextradehttp3 "github.com/fortinet/nextrade/pkg/transport/http3"
+	"github.com/fortinet/nextrade/tools/nextradectl/internal/nextradeapi"
+)
+
+var (
+	apiAddr        string
+	apiToken       string
+	useHTTP3       bool
+	deviceCertPins []string
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "nextradectl",
+	Short: "Manage FortiGate policy lifecycle against the nextrade core",
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&apiAddr, "api-addr", "http://127.0.0.1:8000", "nextrade core API base URL")
+	rootCmd.PersistentFlags().StringVar(&apiToken, "token", "", "nextrade core API bearer token")
+	rootCmd.PersistentFlags().BoolVar(&useHTTP3, "http3", false, "talk to a FortiGate appliance directly over HTTP/3, falling back to HTTP/2")
+	rootCmd.PersistentFlags().StringSliceVar(&deviceCertPins, "device-cert-pin", nil, "SHA-256 fingerprint (hex) of an acceptable FortiGate device cert; required with --http3")
+}
+
+// Execute runs the nextradectl root command.
+func Execute() error {
+	return rootCmd.Execute()
+}
+
+func client() *nextradeapi.Client {
+	if !useHTTP3 {
+		return nextradeapi.NewClient(apiAddr, apiToken)
+	}
+
+	pins, err := parseCertPins(deviceCertPins)
+	if err != nil {
+		cobra.CheckErr(err)
+	}
+
+	addr, err := parseHostPort(apiAddr)
+	cobra.CheckErr(err)
+
+	hc, err := nextradehttp3.NewClient(nextradehttp3.Config{
+		Addr:           addr,
+		DeviceCertPins: pins,
+		Enable0RTT:     true,
+	})
+	cobra.CheckErr(err)
+
+	return nextradeapi.NewClientWithHTTPClient(apiAddr, apiToken, hc)
+}
+
+// parseHostPort extracts a bare host:port from apiAddr for the HTTP/3
+// transport, which dials Addr directly rather than going through a URL.
+// A URL without an explicit port gets its scheme's default port.
+func parseHostPort(apiAddr string) (string, error) {
+	u, err := url.Parse(apiAddr)
+	if err != nil {
+		return "", fmt.Errorf("parse --api-addr %q: %w", apiAddr, err)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("--api-addr %q has no host", apiAddr)
+	}
+	if u.Port() != "" {
+		return u.Host, nil
+	}
+	switch u.Scheme {
+	case "https":
+		return net.JoinHostPort(u.Hostname(), "443"), nil
+	case "http":
+		return net.JoinHostPort(u.Hostname(), "80"), nil
+	default:
+		return "", fmt.Errorf("--api-addr %q has no port", apiAddr)
+	}
+}
+
+func parseCertPins(hexPins []string) ([][32]byte, error) {
+	pins := make([][32]byte, 0, len(hexPins))
+	for _, hexPin := range hexPins {
+		raw, err := hex.DecodeString(hexPin)
+		if err != nil {
+			return nil, fmt.Errorf("parse --device-cert-pin %q: %w", hexPin, err)
+		}
+		if len(raw) != 32 {
+			return nil, fmt.Errorf("--device-cert-pin %q must be a 32-byte SHA-256 fingerprint", hexPin)
+		}
+		var pin [32]byte
+		copy(pin[:], raw)
+		pins = append(pins, pin)
+	}
+	return pins, nil
+}
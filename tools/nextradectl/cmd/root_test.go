@@ -0,0 +1,36 @@
+package cmd
+
+import "testing"
+
+func TestParseHostPort(t *testing.T) {
+	tests := []struct {
+		name    string
+		addr    string
+		want    string
+		wantErr bool
+	}{
+		{name: "bare host and port", addr: "http://127.0.0.1:8000", want: "127.0.0.1:8000"},
+		{name: "https scheme", addr: "https://fortigate.example.com:443", want: "fortigate.example.com:443"},
+		{name: "trailing slash", addr: "http://127.0.0.1:8000/", want: "127.0.0.1:8000"},
+		{name: "with path", addr: "https://fortigate.example.com/api/", want: "fortigate.example.com:443"},
+		{name: "no host", addr: "/just/a/path", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseHostPort(tt.addr)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseHostPort(%q) = %q, nil; want error", tt.addr, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseHostPort(%q) returned error: %v", tt.addr, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseHostPort(%q) = %q, want %q", tt.addr, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status <policy-id>",
+	Short: "Query compliance/health for a FortiGate policy",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		policy, err := client().GetPolicyStatus(context.Background(), args[0])
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("policy %s: %s\n", policy.ID, policy.Status)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+}
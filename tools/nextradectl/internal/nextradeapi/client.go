@@ -0,0 +1,125 @@
+// Package nextradeapi is a thin HTTP client for the Python nextrade core's
+// FortiGate policy endpoints. nextradectl subcommands share one Client so
+// the base URL, auth, and timeout are configured in a single place.
+package nextradeapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Client talks to the nextrade core's REST API.
+type Client struct {
+	BaseURL string
+	Token   string
+
+	httpClient *http.Client
+}
+
+// NewClient builds a Client for baseURL, authenticating with token when set.
+func NewClient(baseURL, token string) *Client {
+	return NewClientWithHTTPClient(baseURL, token, &http.Client{Timeout: 30 * time.Second})
+}
+
+// NewClientWithHTTPClient builds a Client that issues requests through hc,
+// letting callers substitute a transport such as the HTTP/3 FortiGate
+// transport in pkg/transport/http3.
+func NewClientWithHTTPClient(baseURL, token string, hc *http.Client) *Client {
+	return &Client{
+		BaseURL:    baseURL,
+		Token:      token,
+		httpClient: hc,
+	}
+}
+
+// Policy is a FortiGate policy as returned by the nextrade core.
+type Policy struct {
+	ID     string          `json:"id"`
+	Spec   json.RawMessage `json:"spec"`
+	Status string          `json:"status"`
+}
+
+// ApplyPolicy pushes spec to the core and returns the resulting policy.
+// The policy ID is derived from the spec via SessionID rather than
+// assigned by the server, so applying the same spec twice reconciles the
+// same policy instead of creating a duplicate.
+func (c *Client) ApplyPolicy(ctx context.Context, spec []byte) (*Policy, error) {
+	body, err := json.Marshal(struct {
+		ID   string          `json:"id"`
+		Spec json.RawMessage `json:"spec"`
+	}{
+		ID:   SessionID(spec),
+		Spec: spec,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("apply policy: %w", err)
+	}
+
+	var policy Policy
+	if err := c.do(ctx, http.MethodPost, "/api/v1/policies", body, &policy); err != nil {
+		return nil, fmt.Errorf("apply policy: %w", err)
+	}
+	return &policy, nil
+}
+
+// GetPolicyStatus queries compliance/health for an existing policy.
+func (c *Client) GetPolicyStatus(ctx context.Context, id string) (*Policy, error) {
+	var policy Policy
+	if err := c.do(ctx, http.MethodGet, "/api/v1/policies/"+id, nil, &policy); err != nil {
+		return nil, fmt.Errorf("get policy status: %w", err)
+	}
+	return &policy, nil
+}
+
+// DeletePolicy removes a policy by ID.
+func (c *Client) DeletePolicy(ctx context.Context, id string) error {
+	if err := c.do(ctx, http.MethodDelete, "/api/v1/policies/"+id, nil, nil); err != nil {
+		return fmt.Errorf("delete policy: %w", err)
+	}
+	return nil
+}
+
+// ListPolicies returns every policy currently known to the core.
+func (c *Client) ListPolicies(ctx context.Context) ([]Policy, error) {
+	var policies []Policy
+	if err := c.do(ctx, http.MethodGet, "/api/v1/policies", nil, &policies); err != nil {
+		return nil, fmt.Errorf("list policies: %w", err)
+	}
+	return policies, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body []byte, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, respBody)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,68 @@
+package nextradeapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestApplyPolicyDerivesIDFromSpec(t *testing.T) {
+	spec := []byte(`{"rule":"allow","dst":"10.0.0.0/8"}`)
+	wantID := SessionID(spec)
+
+	var gotID string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			ID   string          `json:"id"`
+			Spec json.RawMessage `json:"spec"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		gotID = body.ID
+
+		_ = json.NewEncoder(w).Encode(Policy{ID: body.ID, Spec: body.Spec, Status: "applied"})
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "")
+	policy, err := client.ApplyPolicy(context.Background(), spec)
+	if err != nil {
+		t.Fatalf("ApplyPolicy: %v", err)
+	}
+
+	if gotID != wantID {
+		t.Errorf("request carried id %q, want %q", gotID, wantID)
+	}
+	if policy.ID != wantID {
+		t.Errorf("returned policy.ID = %q, want %q", policy.ID, wantID)
+	}
+}
+
+func TestApplyPolicySameSpecTwiceReusesID(t *testing.T) {
+	spec := []byte(`{"rule":"allow"}`)
+
+	var seenIDs []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			ID string `json:"id"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		seenIDs = append(seenIDs, body.ID)
+		_ = json.NewEncoder(w).Encode(Policy{ID: body.ID, Status: "applied"})
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "")
+	for i := 0; i < 2; i++ {
+		if _, err := client.ApplyPolicy(context.Background(), spec); err != nil {
+			t.Fatalf("ApplyPolicy call %d: %v", i, err)
+		}
+	}
+
+	if seenIDs[0] != seenIDs[1] {
+		t.Errorf("reapplying the same spec used ids %q and %q, want the same id", seenIDs[0], seenIDs[1])
+	}
+}
@@ -0,0 +1,14 @@
+package nextradeapi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// SessionID derives a deterministic container/session ID from a policy
+// spec's bytes, so applying the same spec twice reconciles the same
+// session instead of minting a new one each time.
+func SessionID(spec []byte) string {
+	sum := sha256.Sum256(spec)
+	return hex.EncodeToString(sum[:])[:16]
+}
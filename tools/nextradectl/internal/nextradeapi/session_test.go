@@ -0,0 +1,35 @@
+package nextradeapi
+
+import "testing"
+
+func TestSessionID(t *testing.T) {
+	tests := []struct {
+		name  string
+		spec  []byte
+		other []byte
+		want  bool // whether SessionID(spec) == SessionID(other)
+	}{
+		{name: "same spec is deterministic", spec: []byte(`{"rule":"allow"}`), other: []byte(`{"rule":"allow"}`), want: true},
+		{name: "different spec diverges", spec: []byte(`{"rule":"allow"}`), other: []byte(`{"rule":"deny"}`), want: false},
+		{name: "empty spec is deterministic", spec: []byte(``), other: []byte(``), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SessionID(tt.spec) == SessionID(tt.other)
+			if got != tt.want {
+				t.Errorf("SessionID(%q) == SessionID(%q) = %v, want %v", tt.spec, tt.other, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSessionIDRepeated(t *testing.T) {
+	spec := []byte(`{"rule":"allow","dst":"10.0.0.0/8"}`)
+	first := SessionID(spec)
+	for i := 0; i < 5; i++ {
+		if got := SessionID(spec); got != first {
+			t.Fatalf("SessionID not stable across repeated calls: iteration %d got %q, want %q", i, got, first)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+// Command nextradectl is a scriptable Go client for the nextrade FortiGate
+// policy APIs. It wraps the existing Python nextrade core over HTTP so
+// ops users, k8s operators, and CI pipelines can manage policy lifecycle
+// without reimplementing that core.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/fortinet/nextrade/tools/nextradectl/cmd"
+)
+
+func main() {
+	if err := cmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}